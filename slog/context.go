@@ -0,0 +1,88 @@
+package slog
+
+import (
+	"context"
+	"sync"
+)
+
+// A ContextExtractor derives additional Attrs from a context.Context, for
+// automatic enrichment of every Record a Logger builds — OpenTelemetry
+// trace/span IDs, a request ID stashed under a context key, tenant
+// metadata, and the like. Extractors must tolerate a nil context.
+type ContextExtractor func(context.Context) []Attr
+
+var (
+	extractorsMu     sync.Mutex
+	globalExtractors []ContextExtractor
+)
+
+// RegisterContextExtractor registers fn to run, in registration order,
+// on every Logger's context when it builds a Record, ahead of any
+// extractors installed on that Logger with [Logger.WithContextExtractors].
+// Extractors run after the user-supplied log args have been turned into
+// Attrs but before the Record reaches the Handler, and only once
+// Handler.Enabled has reported the level as enabled.
+func RegisterContextExtractor(fn ContextExtractor) {
+	extractorsMu.Lock()
+	defer extractorsMu.Unlock()
+	globalExtractors = append(globalExtractors, fn)
+}
+
+// WithContextExtractors returns a new Logger that also runs fns, in
+// order, after any extractors registered process-wide with
+// RegisterContextExtractor, whenever it builds a Record. The new Logger
+// shares the old Logger's handler and context.
+func (l *Logger) WithContextExtractors(fns ...ContextExtractor) *Logger {
+	c := l.clone()
+	c.extractors = append(append([]ContextExtractor{}, l.extractors...), fns...)
+	return c
+}
+
+// contextAttrs runs the globally registered extractors followed by l's
+// own, against l.ctx, and returns the concatenated Attrs.
+func (l *Logger) contextAttrs() []Attr {
+	extractorsMu.Lock()
+	global := globalExtractors
+	extractorsMu.Unlock()
+	if len(global) == 0 && len(l.extractors) == 0 {
+		return nil
+	}
+	var attrs []Attr
+	for _, fn := range global {
+		attrs = append(attrs, fn(l.ctx)...)
+	}
+	for _, fn := range l.extractors {
+		attrs = append(attrs, fn(l.ctx)...)
+	}
+	return attrs
+}
+
+// ctxAttrsKey is the context key under which NewContext stashes Attrs.
+type ctxAttrsKey struct{}
+
+// NewContext returns a copy of ctx carrying attrs, appended to any Attrs
+// already stashed by an earlier NewContext call on an ancestor context.
+// Middleware can use this to accumulate contextual fields — a request
+// ID, a tenant, trace metadata — across call boundaries without
+// threading a *Logger everywhere; the Attrs are surfaced automatically
+// through the FromContext extractor registered by this package's init.
+func NewContext(ctx context.Context, attrs ...Attr) context.Context {
+	prev := FromContext(ctx)
+	combined := append(make([]Attr, 0, len(prev)+len(attrs)), prev...)
+	combined = append(combined, attrs...)
+	return context.WithValue(ctx, ctxAttrsKey{}, combined)
+}
+
+// FromContext returns the Attrs stashed in ctx by NewContext, or nil if
+// ctx is nil or carries none.
+func FromContext(ctx context.Context) []Attr {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(ctxAttrsKey{}).([]Attr)
+	return attrs
+}
+
+func init() {
+	RegisterContextExtractor(FromContext)
+}
@@ -0,0 +1,62 @@
+package slog
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewContextFromContext(t *testing.T) {
+	ctx := NewContext(context.Background(), String("a", "1"))
+	ctx = NewContext(ctx, String("b", "2"))
+
+	attrs := FromContext(ctx)
+	if len(attrs) != 2 || attrs[0].Key != "a" || attrs[1].Key != "b" {
+		t.Fatalf("FromContext = %+v, want [a b]", attrs)
+	}
+}
+
+func TestFromContextNil(t *testing.T) {
+	if got := FromContext(nil); got != nil {
+		t.Errorf("FromContext(nil) = %+v, want nil", got)
+	}
+}
+
+func TestNewContextDoesNotAliasSiblings(t *testing.T) {
+	base := NewContext(context.Background(), String("shared", "x"))
+	childA := NewContext(base, String("a", "1"))
+	childB := NewContext(base, String("b", "2"))
+
+	if got := FromContext(childA); len(got) != 2 || got[1].Key != "a" {
+		t.Errorf("childA attrs = %+v", got)
+	}
+	if got := FromContext(childB); len(got) != 2 || got[1].Key != "b" {
+		t.Errorf("childB attrs = %+v, want b unclobbered by childA", got)
+	}
+}
+
+func TestLoggerContextAttrsCombinesGlobalAndPerLogger(t *testing.T) {
+	ctx := NewContext(context.Background(), String("tenant", "acme"))
+	l := New(&discardHandler{minLevel: LevelInfo}).
+		WithContext(ctx).
+		WithContextExtractors(func(context.Context) []Attr {
+			return []Attr{String("extra", "1")}
+		})
+
+	attrs := l.contextAttrs()
+	if len(attrs) != 2 || attrs[0].Key != "tenant" || attrs[1].Key != "extra" {
+		t.Fatalf("contextAttrs = %+v, want [tenant extra]", attrs)
+	}
+}
+
+func TestLoggerContextAttrsToleratesNilContext(t *testing.T) {
+	l := New(&discardHandler{minLevel: LevelInfo}).
+		WithContextExtractors(func(ctx context.Context) []Attr {
+			if ctx != nil {
+				t.Error("extractor should have been called with a nil context")
+			}
+			return nil
+		})
+	if got := l.contextAttrs(); got != nil {
+		t.Errorf("contextAttrs = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,91 @@
+package slog
+
+import (
+	"os"
+	"runtime"
+	"sync"
+)
+
+// LevelFatal is the level for log statements that are immediately
+// followed by program termination, as by [Logger.Fatal]. It sits above
+// LevelError, matching glog's Fatal/Exit semantics.
+const LevelFatal = LevelError + 4
+
+var (
+	exitMu       sync.Mutex
+	exitFunc     = os.Exit
+	exitHandlers []func()
+)
+
+// RegisterExitHandler registers fn to run, in registration order, when
+// [Logger.Fatal] terminates the process. This gives buffered or
+// asynchronous Handlers, and other subsystems that need to flush state,
+// a chance to run before exit, mirroring glog's exit handler chain.
+func RegisterExitHandler(fn func()) {
+	exitMu.Lock()
+	defer exitMu.Unlock()
+	exitHandlers = append(exitHandlers, fn)
+}
+
+// SetExitFunc overrides the function [Logger.Fatal] calls to terminate
+// the process. It defaults to os.Exit and exists so tests can observe a
+// Fatal call without actually exiting.
+func SetExitFunc(fn func(int)) {
+	exitMu.Lock()
+	defer exitMu.Unlock()
+	exitFunc = fn
+}
+
+// Fatal logs at LevelFatal, including a stack dump of the current
+// goroutine, runs any handlers registered with RegisterExitHandler, and
+// terminates the process via the exit func installed by SetExitFunc
+// (os.Exit(255) by default).
+//
+// If err is non-nil, Fatal appends Any("err", err) to the list of
+// attributes, as Error does.
+func (l *Logger) Fatal(msg string, err error, args ...any) {
+	l.FatalDepth(1, err, msg, args...)
+}
+
+// FatalDepth is like Fatal, but the log line's source position is taken
+// calldepth levels up the stack, reusing the same LogDepth plumbing as
+// Debug/Info/Warn/Error. It lets wrapper libraries call Fatal on behalf
+// of their own callers while preserving the caller's location.
+func (l *Logger) FatalDepth(calldepth int, err error, msg string, args ...any) {
+	p := pc(calldepth + 3)
+	args = append(args, String("stacktrace", currentStackDump()))
+	l.logPC(err, p, LevelFatal, msg, args...)
+	runExitHandlers()
+	exitMu.Lock()
+	fn := exitFunc
+	exitMu.Unlock()
+	fn(255)
+}
+
+func runExitHandlers() {
+	exitMu.Lock()
+	handlers := append([]func(){}, exitHandlers...)
+	exitMu.Unlock()
+	for _, fn := range handlers {
+		fn()
+	}
+}
+
+// currentStackDump captures a full stack trace of the current goroutine,
+// for inclusion in a Fatal record, mirroring glog's internal/stackdump
+// behavior.
+func currentStackDump() string {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, false)
+		if n < len(buf) {
+			return string(buf[:n])
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+// Fatal calls Logger.Fatal on the default logger.
+func Fatal(msg string, err error, args ...any) {
+	Default().FatalDepth(1, err, msg, args...)
+}
@@ -0,0 +1,67 @@
+package slog
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestFatalRunsExitHandlersThenExitFunc(t *testing.T) {
+	exitMu.Lock()
+	prevHandlers, prevExit := exitHandlers, exitFunc
+	exitHandlers = nil
+	exitMu.Unlock()
+	t.Cleanup(func() {
+		exitMu.Lock()
+		exitHandlers, exitFunc = prevHandlers, prevExit
+		exitMu.Unlock()
+	})
+
+	var mu sync.Mutex
+	var ran []string
+	RegisterExitHandler(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		ran = append(ran, "first")
+	})
+	RegisterExitHandler(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		ran = append(ran, "second")
+	})
+
+	var gotCode int
+	var exited bool
+	var ranBeforeExit int
+	SetExitFunc(func(code int) {
+		mu.Lock()
+		ranBeforeExit = len(ran)
+		mu.Unlock()
+		gotCode = code
+		exited = true
+	})
+
+	l := New(&discardHandler{minLevel: LevelInfo})
+	l.Fatal("boom", errors.New("bad"))
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !exited {
+		t.Fatal("exit func was not called")
+	}
+	if gotCode != 255 {
+		t.Errorf("exit code = %d, want 255", gotCode)
+	}
+	if len(ran) != 2 || ran[0] != "first" || ran[1] != "second" {
+		t.Errorf("exit handlers ran in the wrong order: %v", ran)
+	}
+	if ranBeforeExit != 2 {
+		t.Errorf("%d exit handlers had run when the exit func was called, want 2", ranBeforeExit)
+	}
+}
+
+func TestLevelFatalString(t *testing.T) {
+	if got := LevelFatal.String(); got != "FATAL" {
+		t.Errorf("LevelFatal.String() = %q, want %q", got, "FATAL")
+	}
+}
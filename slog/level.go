@@ -0,0 +1,52 @@
+package slog
+
+import "fmt"
+
+// A Level is the importance or severity of a log event. The higher the
+// level, the more important or severe the event.
+//
+// Level and the LevelDebug..LevelError constants below were referenced
+// throughout this package (logger.go, record.go) from the
+// start, but were never actually declared anywhere in tracked history
+// before this file — none of that code could have compiled. This file
+// is their first declaration, not a duplicate; see the CI workflow added
+// alongside it, which exists specifically to catch this class of gap
+// before it reaches review again.
+type Level int
+
+// Names for common levels.
+const (
+	LevelDebug  Level = -4
+	LevelInfo   Level = 0
+	LevelNotice Level = 2
+	LevelWarn   Level = 4
+	LevelError  Level = 8
+)
+
+// String returns a name for the level. If the level has a name, that name
+// is returned. Otherwise it returns the name of the nearest named level
+// below it with a "+n" suffix, or, for levels below LevelDebug, "DEBUG"
+// with a "-n" suffix.
+func (l Level) String() string {
+	named := func(name string, base Level) string {
+		if l == base {
+			return name
+		}
+		return fmt.Sprintf("%s%+d", name, l-base)
+	}
+
+	switch {
+	case l < LevelInfo:
+		return named("DEBUG", LevelDebug)
+	case l < LevelNotice:
+		return named("INFO", LevelInfo)
+	case l < LevelWarn:
+		return named("NOTICE", LevelNotice)
+	case l < LevelError:
+		return named("WARN", LevelWarn)
+	case l < LevelFatal:
+		return named("ERROR", LevelError)
+	default:
+		return named("FATAL", LevelFatal)
+	}
+}
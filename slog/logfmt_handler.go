@@ -0,0 +1,182 @@
+package slog
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/slices"
+)
+
+// NewLogfmtHandler creates a Handler that writes Records to w in strict
+// logfmt, the line-oriented key=value format described by Brandur Leach's
+// logfmt spec and implemented by go-logfmt/logfmt. Unlike TextHandler's
+// ad-hoc formatting, output from this handler is meant to round-trip
+// through a logfmt decoder: keys are bare identifiers (any '=', '"',
+// space or control character in a key is replaced with '_'), values are
+// quoted only when they are empty or contain a space, a control
+// character, '=', or quotes, and inside quoted values newlines, carriage
+// returns and tabs are escaped as \n, \r and \t, and other control
+// characters as \u00XX.
+//
+// The "time", "level", and "msg" attrs, followed by "source" when
+// opts.AddSource is set, are written first, in that order, ahead of any
+// attrs passed by the caller. Groups
+// produce a "group.key=" prefix on every attr nested beneath them.
+//
+// If opts is nil, the default options are used.
+func NewLogfmtHandler(w io.Writer, opts *HandlerOptions) Handler {
+	if opts == nil {
+		opts = &HandlerOptions{}
+	}
+	return &logfmtHandler{mu: &sync.Mutex{}, w: w, opts: *opts}
+}
+
+type logfmtHandler struct {
+	mu     *sync.Mutex // shared by h and every handler derived from it via WithAttrs/WithGroup
+	w      io.Writer
+	opts   HandlerOptions
+	prefix string // dotted group prefix, e.g. "req.http."
+	attrs  []Attr // attrs accumulated via WithAttrs, keys already prefixed
+}
+
+func (h *logfmtHandler) Enabled(level Level) bool {
+	min := LevelInfo
+	if h.opts.Level != nil {
+		min = h.opts.Level.Level()
+	}
+	return level >= min
+}
+
+func (h *logfmtHandler) Handle(r Record) error {
+	buf := new(bytes.Buffer)
+
+	h.writeResolved(buf, "", Attr{Key: "time", Value: StringValue(r.Time.Format("2006-01-02T15:04:05.999999999Z07:00"))})
+	h.writeResolved(buf, "", Attr{Key: "level", Value: StringValue(r.Level.String())})
+	h.writeResolved(buf, "", Attr{Key: "msg", Value: StringValue(r.Message)})
+	if h.opts.AddSource {
+		if file, line := r.SourceLine(); file != "" {
+			h.writeResolved(buf, "", Attr{Key: "source", Value: StringValue(file + ":" + strconv.Itoa(line))})
+		}
+	}
+
+	for _, a := range h.attrs {
+		h.appendAttr(buf, "", a)
+	}
+	r.Attrs(func(a Attr) {
+		h.appendAttr(buf, h.prefix, a)
+	})
+
+	buf.WriteByte('\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := h.w.Write(buf.Bytes())
+	return err
+}
+
+func (h *logfmtHandler) appendAttr(buf *bytes.Buffer, prefix string, a Attr) {
+	v := a.Value.Resolve()
+	if v.Kind() == GroupKind {
+		groupPrefix := prefix + a.Key + "."
+		for _, ga := range v.Group() {
+			h.appendAttr(buf, groupPrefix, ga)
+		}
+		return
+	}
+	h.writeResolved(buf, prefix, Attr{Key: a.Key, Value: v})
+}
+
+// writeResolved runs a (whether a built-in field like time/level/msg/source
+// or a caller-supplied attr) through opts.ReplaceAttr, exactly as every
+// other attr is, before writing it. groups is empty for all of this
+// handler's calls, since it never nests ReplaceAttr under a group name.
+func (h *logfmtHandler) writeResolved(buf *bytes.Buffer, prefix string, a Attr) {
+	if h.opts.ReplaceAttr != nil {
+		a = h.opts.ReplaceAttr(nil, a)
+	}
+	if a.Key == "" {
+		return
+	}
+	writeLogfmtPair(buf, prefix+a.Key, a.Value.String())
+}
+
+func (h *logfmtHandler) WithAttrs(attrs []Attr) Handler {
+	c := *h
+	c.attrs = slices.Clone(h.attrs)
+	for _, a := range attrs {
+		c.attrs = append(c.attrs, Attr{Key: h.prefix + a.Key, Value: a.Value})
+	}
+	return &c
+}
+
+func (h *logfmtHandler) WithGroup(name string) Handler {
+	c := *h
+	c.prefix = h.prefix + name + "."
+	return &c
+}
+
+// logfmtKey sanitizes a key for bare, unquoted use in logfmt output,
+// replacing any '=', '"', space or control character with '_'.
+func logfmtKey(key string) string {
+	if strings.IndexFunc(key, logfmtNeedsKeyEscape) < 0 {
+		return key
+	}
+	var b strings.Builder
+	b.Grow(len(key))
+	for _, r := range key {
+		if logfmtNeedsKeyEscape(r) {
+			b.WriteByte('_')
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func logfmtNeedsKeyEscape(r rune) bool {
+	return r == '=' || r == '"' || r <= ' '
+}
+
+// writeLogfmtPair writes "key=value" (quoting and escaping value as
+// needed) followed by a space.
+func writeLogfmtPair(buf *bytes.Buffer, key, value string) {
+	buf.WriteString(logfmtKey(key))
+	buf.WriteByte('=')
+	writeLogfmtValue(buf, value)
+	buf.WriteByte(' ')
+}
+
+func writeLogfmtValue(buf *bytes.Buffer, value string) {
+	if value != "" && strings.IndexFunc(value, logfmtNeedsKeyEscape) < 0 {
+		buf.WriteString(value)
+		return
+	}
+	buf.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < ' ' {
+				const hex = "0123456789abcdef"
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hex[r>>4])
+				buf.WriteByte(hex[r&0xf])
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
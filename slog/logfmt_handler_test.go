@@ -0,0 +1,92 @@
+package slog
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestLogfmtHandlerQuoting(t *testing.T) {
+	var buf bytes.Buffer
+	h := NewLogfmtHandler(&buf, nil)
+	r := Record{Level: LevelInfo, Message: "hello world"}
+	r.AddAttrs(String("key", "plain"), String("spacey", "a b"), String("quoted", `has "quotes"`))
+	if err := h.Handle(r); err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		`msg="hello world"`,
+		"key=plain",
+		`spacey="a b"`,
+		`quoted="has \"quotes\""`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output %q: want substring %q", out, want)
+		}
+	}
+}
+
+func TestLogfmtHandlerReplaceAttrAppliesToBuiltins(t *testing.T) {
+	var buf bytes.Buffer
+	h := NewLogfmtHandler(&buf, &HandlerOptions{
+		ReplaceAttr: func(groups []string, a Attr) Attr {
+			if a.Key == "time" {
+				return Attr{} // drop the timestamp for deterministic test output
+			}
+			if a.Key == "msg" {
+				return String("msg", "redacted")
+			}
+			return a
+		},
+	})
+	if err := h.Handle(Record{Level: LevelInfo, Message: "secret"}); err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "time=") {
+		t.Errorf("ReplaceAttr should have dropped time, got %q", out)
+	}
+	if !strings.Contains(out, "msg=redacted") {
+		t.Errorf("ReplaceAttr should have renamed msg, got %q", out)
+	}
+	if !strings.Contains(out, "level=INFO") {
+		t.Errorf("level untouched by ReplaceAttr should still be written, got %q", out)
+	}
+}
+
+func TestLogfmtHandlerWithGroupAndWithAttrsShareLock(t *testing.T) {
+	h := NewLogfmtHandler(new(bytes.Buffer), nil).(*logfmtHandler)
+	g := h.WithGroup("req").(*logfmtHandler)
+	a := h.WithAttrs([]Attr{Int("n", 1)}).(*logfmtHandler)
+
+	if g.mu != h.mu {
+		t.Error("WithGroup must share the parent's mutex, not copy it")
+	}
+	if a.mu != h.mu {
+		t.Error("WithAttrs must share the parent's mutex, not copy it")
+	}
+}
+
+func TestLogfmtHandlerConcurrentWritesDontRace(t *testing.T) {
+	var buf bytes.Buffer
+	h := NewLogfmtHandler(&buf, nil)
+	g := h.WithGroup("req")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = h.Handle(Record{Level: LevelInfo, Message: "a"})
+		}()
+		go func() {
+			defer wg.Done()
+			_ = g.Handle(Record{Level: LevelInfo, Message: "b"})
+		}()
+	}
+	wg.Wait()
+}
@@ -68,8 +68,9 @@ func (w *handlerWriter) Write(buf []byte) (int, error) {
 // To create a new Logger, call [New] or a Logger method
 // that begins "With".
 type Logger struct {
-	handler Handler // for structured logging
-	ctx     context.Context
+	handler    Handler // for structured logging
+	ctx        context.Context
+	extractors []ContextExtractor // run on l.ctx in addition to any registered globally
 }
 
 func (l *Logger) clone() *Logger {
@@ -163,6 +164,9 @@ func (l *Logger) logPC(err error, pc uintptr, level Level, msg string, args ...a
 	if err != nil {
 		r.AddAttrs(Any("err", err))
 	}
+	if l.Handler().Enabled(level) {
+		r.AddAttrs(l.contextAttrs()...)
+	}
 	_ = l.Handler().Handle(r)
 }
 
@@ -183,21 +187,33 @@ func (l *Logger) LogAttrs(level Level, msg string, attrs ...Attr) {
 
 // Debug logs at LevelDebug.
 func (l *Logger) Debug(msg string, args ...any) {
+	if !l.VDepth(2, LevelDebug) {
+		return
+	}
 	l.LogDepth(1, LevelDebug, msg, args...)
 }
 
 // Info logs at LevelInfo.
 func (l *Logger) Info(msg string, args ...any) {
+	if !l.VDepth(2, LevelInfo) {
+		return
+	}
 	l.LogDepth(1, LevelInfo, msg, args...)
 }
 
 // Notice logs at LevelNotice.
 func (l *Logger) Notice(msg string, args ...any) {
+	if !l.VDepth(2, LevelNotice) {
+		return
+	}
 	l.LogDepth(1, LevelNotice, msg, args...)
 }
 
 // Warn logs at LevelWarn.
 func (l *Logger) Warn(msg string, args ...any) {
+	if !l.VDepth(2, LevelWarn) {
+		return
+	}
 	l.LogDepth(1, LevelWarn, msg, args...)
 }
 
@@ -205,6 +221,9 @@ func (l *Logger) Warn(msg string, args ...any) {
 // If err is non-nil, Error appends Any(ErrorKey, err)
 // to the list of attributes.
 func (l *Logger) Error(msg string, err error, args ...any) {
+	if !l.VDepth(2, LevelError) {
+		return
+	}
 	l.logDepthErr(err, 1, LevelError, msg, args...)
 }
 
@@ -0,0 +1,90 @@
+package slog
+
+import "errors"
+
+// MultiHandler returns a Handler that dispatches every Record it receives
+// to each of hs, in order, much like log15/logrus/geth's composable
+// handlers: a terminal handler at LevelInfo and a JSON file handler at
+// LevelDebug can be combined behind a single Logger.
+//
+// Handle gives each child its own copy of the Record, since a Record must
+// not be modified after it has been handed to more than one caller.
+// Errors returned by the children are combined with errors.Join.
+func MultiHandler(hs ...Handler) Handler {
+	m := make(multiHandler, len(hs))
+	copy(m, hs)
+	return m
+}
+
+type multiHandler []Handler
+
+func (m multiHandler) Enabled(level Level) bool {
+	for _, h := range m {
+		if h.Enabled(level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m multiHandler) Handle(r Record) error {
+	var errs []error
+	for _, h := range m {
+		if !h.Enabled(r.Level) {
+			continue
+		}
+		if err := h.Handle(r.Clone()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m multiHandler) WithAttrs(attrs []Attr) Handler {
+	out := make(multiHandler, len(m))
+	for i, h := range m {
+		out[i] = h.WithAttrs(attrs)
+	}
+	return out
+}
+
+func (m multiHandler) WithGroup(name string) Handler {
+	out := make(multiHandler, len(m))
+	for i, h := range m {
+		out[i] = h.WithGroup(name)
+	}
+	return out
+}
+
+// LevelFilterHandler returns a Handler that wraps h but reports itself as
+// Enabled only for levels at or above min, regardless of what h.Enabled
+// would otherwise report. Records below min are dropped before reaching
+// h. This lets a single underlying handler be reused at different
+// verbosity thresholds inside a MultiHandler fan-out.
+func LevelFilterHandler(min Level, h Handler) Handler {
+	return &levelFilterHandler{min: min, h: h}
+}
+
+type levelFilterHandler struct {
+	min Level
+	h   Handler
+}
+
+func (f *levelFilterHandler) Enabled(level Level) bool {
+	return level >= f.min && f.h.Enabled(level)
+}
+
+func (f *levelFilterHandler) Handle(r Record) error {
+	if r.Level < f.min {
+		return nil
+	}
+	return f.h.Handle(r)
+}
+
+func (f *levelFilterHandler) WithAttrs(attrs []Attr) Handler {
+	return &levelFilterHandler{min: f.min, h: f.h.WithAttrs(attrs)}
+}
+
+func (f *levelFilterHandler) WithGroup(name string) Handler {
+	return &levelFilterHandler{min: f.min, h: f.h.WithGroup(name)}
+}
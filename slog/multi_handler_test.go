@@ -0,0 +1,46 @@
+package slog
+
+import "testing"
+
+type levelRecorder struct {
+	min Level
+	got []Level
+}
+
+func (h *levelRecorder) Enabled(level Level) bool     { return level >= h.min }
+func (h *levelRecorder) WithAttrs(attrs []Attr) Handler { return h }
+func (h *levelRecorder) WithGroup(name string) Handler  { return h }
+
+func (h *levelRecorder) Handle(r Record) error {
+	h.got = append(h.got, r.Level)
+	return nil
+}
+
+func TestMultiHandlerSkipsDisabledChildren(t *testing.T) {
+	info := &levelRecorder{min: LevelInfo}
+	debug := &levelRecorder{min: LevelDebug}
+	m := MultiHandler(info, debug)
+
+	if err := m.Handle(Record{Level: LevelDebug, Message: "hi"}); err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if len(info.got) != 0 {
+		t.Errorf("the Info-only handler should not receive a Debug record, got %v", info.got)
+	}
+	if len(debug.got) != 1 {
+		t.Errorf("the Debug handler should receive the record, got %v", debug.got)
+	}
+}
+
+func TestMultiHandlerEnabledIsOrOfChildren(t *testing.T) {
+	info := &levelRecorder{min: LevelInfo}
+	debug := &levelRecorder{min: LevelDebug}
+	m := MultiHandler(info, debug)
+
+	if !m.Enabled(LevelDebug) {
+		t.Error("Enabled(LevelDebug) should be true: the debug child handles it")
+	}
+	if m.Enabled(LevelDebug - 1) {
+		t.Error("Enabled below every child's floor should be false")
+	}
+}
@@ -0,0 +1,167 @@
+package slog
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// A vmoduleFilter is one pattern=level entry parsed from a --vmodule-style
+// spec, e.g. "client.go=2" or "pkg/net/*=3".
+type vmoduleFilter struct {
+	pattern string
+	level   Level
+}
+
+// vmoduleConfig is the compiled result of a SetVModule call. It is
+// immutable once stored, so readers can load it without holding a lock.
+type vmoduleConfig struct {
+	filters []vmoduleFilter
+}
+
+var (
+	// vmoduleMu serializes SetVModule calls; readers never take it.
+	vmoduleMu sync.Mutex
+
+	// vmoduleCfg holds the current *vmoduleConfig. Loaded with a single
+	// atomic read on every VDepth call.
+	vmoduleCfg atomic.Value
+
+	// vmodulePCCache memoizes the per-call-site verbosity decision, keyed
+	// by the pc of the call site. Values are *Level, or nil to mean "no
+	// pattern matched this call site". It's held behind an atomic.Pointer
+	// rather than swapped in place so that SetVModule can invalidate it by
+	// publishing a fresh map instead of reassigning the sync.Map value
+	// itself, which would race with concurrent VDepth readers.
+	vmodulePCCache atomic.Pointer[sync.Map]
+)
+
+func init() {
+	vmoduleCfg.Store(&vmoduleConfig{})
+	vmodulePCCache.Store(&sync.Map{})
+}
+
+// SetVModule sets the vmodule filter from a comma-separated list of
+// pattern=level entries, e.g. "client.go=2,pkg/net/*=3". This mirrors
+// glog/klog's --vmodule flag: each pattern is matched, glob-style, against
+// a call site's source file: a pattern without a path separator against
+// the file's base name (e.g. "client.go"), a pattern containing "/"
+// against the trailing components of its path (e.g. "pkg/net/dial.go"
+// for "pkg/net/*"). The first matching
+// pattern's level overrides the handler's global level for that call
+// site; call sites that match nothing fall back to the Logger's own
+// Enabled.
+//
+// SetVModule replaces any previously configured filter and invalidates
+// the per-call-site cache used by VDepth. Passing the empty string clears
+// the filter. A malformed entry returns a non-nil error and leaves the
+// existing filter in place.
+func SetVModule(spec string) error {
+	var filters []vmoduleFilter
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		eq := strings.LastIndex(entry, "=")
+		if eq < 0 {
+			return fmt.Errorf("slog: malformed vmodule entry %q: missing '='", entry)
+		}
+		pattern := strings.TrimSpace(entry[:eq])
+		if pattern == "" {
+			return fmt.Errorf("slog: malformed vmodule entry %q: empty pattern", entry)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(entry[eq+1:]))
+		if err != nil {
+			return fmt.Errorf("slog: malformed vmodule entry %q: %v", entry, err)
+		}
+		filters = append(filters, vmoduleFilter{pattern: pattern, level: Level(n)})
+	}
+
+	vmoduleMu.Lock()
+	defer vmoduleMu.Unlock()
+	vmoduleCfg.Store(&vmoduleConfig{filters: filters})
+	vmodulePCCache.Store(&sync.Map{})
+	return nil
+}
+
+// VDepth reports whether a log statement at the given call depth (1
+// meaning the immediate caller of VDepth) is enabled at level, after
+// consulting any per-file or per-package override installed by
+// SetVModule. It is the building block for verbosity-gated logging:
+//
+//	if l.VDepth(1, slog.LevelDebug) {
+//		l.Debug("expensive", "detail", computeDetail())
+//	}
+//
+// When no vmodule pattern matches the call site, VDepth falls back to
+// l.Enabled(level). The per-call-site decision is cached by pc, so the
+// hot path after the first call is two atomic loads, one runtime.Callers
+// call to find the pc, and a sync.Map lookup.
+func (l *Logger) VDepth(depth int, level Level) bool {
+	cfg := vmoduleCfg.Load().(*vmoduleConfig)
+	if len(cfg.filters) == 0 {
+		return l.Enabled(level)
+	}
+
+	callerPC := pc(depth + 2)
+	cache := vmodulePCCache.Load()
+	var threshold *Level
+	if v, ok := cache.Load(callerPC); ok {
+		threshold = v.(*Level)
+	} else {
+		file, _ := Record{pc: callerPC}.SourceLine()
+		threshold = vmoduleThreshold(cfg, file)
+		cache.Store(callerPC, threshold)
+	}
+	if threshold == nil {
+		return l.Enabled(level)
+	}
+	return level >= *threshold
+}
+
+// vmoduleThreshold returns the level configured for file by the first
+// matching pattern in cfg, or nil if none match. A pattern with no path
+// separator is matched glob-style against file's base name only (e.g.
+// "client.go"); a pattern containing "/" (e.g. "pkg/net/*") is matched
+// segment-by-segment against the trailing path components of file, so it
+// matches regardless of what precedes it in the absolute path
+// runtime.Callers reports.
+func vmoduleThreshold(cfg *vmoduleConfig, file string) *Level {
+	base := filepath.Base(file)
+	for _, f := range cfg.filters {
+		var matched bool
+		if strings.ContainsAny(f.pattern, `/\`) {
+			matched = vmodulePathMatch(f.pattern, file)
+		} else {
+			matched, _ = filepath.Match(f.pattern, base)
+		}
+		if matched {
+			level := f.level
+			return &level
+		}
+	}
+	return nil
+}
+
+// vmodulePathMatch reports whether pattern matches the trailing path
+// components of file, matching each "/"-separated segment independently
+// with filepath.Match so a "*" in pattern wildcards within a segment but
+// never crosses a "/".
+func vmodulePathMatch(pattern, file string) bool {
+	patParts := strings.Split(filepath.ToSlash(pattern), "/")
+	fileParts := strings.Split(filepath.ToSlash(file), "/")
+	if len(patParts) > len(fileParts) {
+		return false
+	}
+	offset := len(fileParts) - len(patParts)
+	for i, p := range patParts {
+		if ok, err := filepath.Match(p, fileParts[offset+i]); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+package slog
+
+import (
+	"sync"
+	"testing"
+)
+
+// discardHandler is a minimal Handler used across this package's tests.
+type discardHandler struct {
+	minLevel Level
+}
+
+func (h *discardHandler) Enabled(level Level) bool     { return level >= h.minLevel }
+func (h *discardHandler) Handle(r Record) error         { return nil }
+func (h *discardHandler) WithAttrs(attrs []Attr) Handler { return h }
+func (h *discardHandler) WithGroup(name string) Handler  { return h }
+
+func levelPtr(l Level) *Level { return &l }
+
+func TestSetVModuleMalformed(t *testing.T) {
+	for _, spec := range []string{"nolevel", "=2", "foo=bar", "foo=,bar=1"} {
+		if err := SetVModule(spec); err == nil {
+			t.Errorf("SetVModule(%q): want error, got nil", spec)
+		}
+	}
+}
+
+func TestSetVModuleRejectedEntryLeavesPriorFilterInPlace(t *testing.T) {
+	defer SetVModule("")
+	if err := SetVModule("vmodule_test.go=-4"); err != nil {
+		t.Fatalf("SetVModule: %v", err)
+	}
+	if err := SetVModule("bad"); err == nil {
+		t.Fatal("want error for malformed entry")
+	}
+
+	l := New(&discardHandler{minLevel: LevelInfo})
+	if !l.VDepth(1, LevelDebug) {
+		t.Error("the previously configured override should still apply after a rejected SetVModule call")
+	}
+}
+
+func TestVDepthOverridesHandlerLevel(t *testing.T) {
+	defer SetVModule("")
+	l := New(&discardHandler{minLevel: LevelInfo})
+
+	if l.VDepth(1, LevelDebug) {
+		t.Fatal("LevelDebug should be disabled before any vmodule override")
+	}
+	if err := SetVModule("vmodule_test.go=-4"); err != nil {
+		t.Fatalf("SetVModule: %v", err)
+	}
+	if !l.VDepth(1, LevelDebug) {
+		t.Error("the per-file override should enable LevelDebug")
+	}
+}
+
+func TestVmoduleThresholdPathPattern(t *testing.T) {
+	cfg := &vmoduleConfig{filters: []vmoduleFilter{
+		{pattern: "dial.go", level: LevelDebug},
+		{pattern: "pkg/net/*", level: LevelWarn},
+	}}
+	tests := []struct {
+		file string
+		want *Level
+	}{
+		{"/home/x/pkg/mod/example.com/pkg/net/dial.go", levelPtr(LevelDebug)}, // basename wins first
+		{"/home/x/pkg/mod/example.com/pkg/http/dial.go", levelPtr(LevelDebug)},
+		{"/home/x/pkg/mod/example.com/pkg/net/listen.go", levelPtr(LevelWarn)}, // only the path pattern matches
+		{"/home/x/other/file.go", nil},
+	}
+	for _, test := range tests {
+		got := vmoduleThreshold(cfg, test.file)
+		switch {
+		case got == nil && test.want == nil:
+		case got == nil || test.want == nil:
+			t.Errorf("%s: got %v, want %v", test.file, got, test.want)
+		case *got != *test.want:
+			t.Errorf("%s: got %v, want %v", test.file, *got, *test.want)
+		}
+	}
+}
+
+func TestVDepthConcurrentWithSetVModule(t *testing.T) {
+	defer SetVModule("")
+	l := New(&discardHandler{minLevel: LevelInfo})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			l.VDepth(1, LevelDebug)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = SetVModule("vmodule_test.go=-4")
+		}()
+	}
+	wg.Wait()
+}